@@ -17,15 +17,24 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"math"
 	"net/http"
 	"net/url"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	jwtgo "github.com/golang-jwt/jwt/v4"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
 	"github.com/prometheus/common/log"
 	"github.com/prometheus/common/version"
+	"gopkg.in/yaml.v2"
 
 	madmin "github.com/minio/madmin-go/v3"
 	minio "github.com/minio/minio-go/v7"
@@ -51,18 +60,78 @@ var (
 		nil,
 		nil,
 	)
+
+	// ttfbBuckets are the histogram boundaries used for minio_s3_ttfb_seconds.
+	// They match the defaults operators typically alert on for S3 SLOs.
+	ttfbBuckets = []float64{.05, .1, .25, .5, 1, 2.5, 5, 10}
+
+	// bucketCacheRequestsTotal tracks hits/misses of the exporter's own
+	// bucket-location and data-usage caches, so operators can see whether
+	// the cache TTLs are actually saving admin-API round-trips.
+	bucketCacheRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "bucket_cache",
+			Name:      "requests_total",
+			Help:      "Total number of bucket metadata cache lookups by cache and result",
+		},
+		[]string{"cache", "result"},
+	)
 )
 
+// clusterMetricsPath is the Prometheus exposition endpoint Minio itself
+// serves cluster-wide request metrics on.
+const clusterMetricsPath = "/minio/v2/metrics/cluster"
+
 // MinioExporter collects Minio statistics using the
 // Prometheus metrics package
 type MinioExporter struct {
-	AdminClient *madmin.AdminClient
-	MinioClient *minio.Client
-	BucketStats bool
+	AdminClient      *madmin.AdminClient
+	MinioClient      *minio.Client
+	BucketStats      bool
+	ReplicationStats bool
+	HealStats        bool
+	BucketWorkers    int
+	LocationCacheTTL time.Duration
+	UsageCacheTTL    time.Duration
+	Endpoint         string
+	Secure           bool
+	AccessKey        string
+	SecretKey        string
+	MetricsToken     string
+
+	locationCacheMu sync.Mutex
+	locationCache   map[string]bucketLocationCacheEntry
+
+	usageCacheMu sync.Mutex
+	usageCache   *dataUsageCacheEntry
+}
+
+// MinioExporterOptions configures the optional, flag-driven behaviour of a
+// MinioExporter. It replaces what used to be a single boolean
+// constructor parameter.
+type MinioExporterOptions struct {
+	BucketStats      bool
+	ReplicationStats bool
+	HealStats        bool
+	BucketWorkers    int
+	LocationCacheTTL time.Duration
+	UsageCacheTTL    time.Duration
+	MetricsToken     string
+}
+
+type bucketLocationCacheEntry struct {
+	location string
+	expires  time.Time
+}
+
+type dataUsageCacheEntry struct {
+	info    madmin.DataUsageInfo
+	expires time.Time
 }
 
 // NewMinioExporter inits and returns a MinioExporter
-func NewMinioExporter(uri string, minioKey string, minioSecret string, bucketStats bool) (*MinioExporter, error) {
+func NewMinioExporter(uri string, minioKey string, minioSecret string, opts MinioExporterOptions) (*MinioExporter, error) {
 	secure := false
 	newURI := uri
 
@@ -98,13 +167,106 @@ func NewMinioExporter(uri string, minioKey string, minioSecret string, bucketSta
 		return nil, fmt.Errorf("Minio client error %s", err)
 	}
 
+	metricsToken := opts.MetricsToken
+	if metricsToken == "" {
+		metricsToken, err = generateMetricsToken(minioKey, minioSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate cluster metrics bearer token: %s", err)
+		}
+	}
+
 	return &MinioExporter{
-		AdminClient: mdmClient,
-		MinioClient: minioClient,
-		BucketStats: bucketStats,
+		AdminClient:      mdmClient,
+		MinioClient:      minioClient,
+		BucketStats:      opts.BucketStats,
+		ReplicationStats: opts.ReplicationStats,
+		HealStats:        opts.HealStats,
+		BucketWorkers:    opts.BucketWorkers,
+		LocationCacheTTL: opts.LocationCacheTTL,
+		UsageCacheTTL:    opts.UsageCacheTTL,
+		Endpoint:         urlMinio.Host,
+		Secure:           secure,
+		AccessKey:        minioKey,
+		SecretKey:        minioSecret,
+		MetricsToken:     metricsToken,
 	}, nil
 }
 
+// generateMetricsToken produces the bearer token Minio's cluster metrics
+// endpoint expects when it isn't running with
+// MINIO_PROMETHEUS_AUTH_TYPE=public: a JWT whose subject is the admin
+// access key, signed with the admin secret key, the same scheme "mc admin
+// prometheus generate" uses.
+func generateMetricsToken(accessKey, secretKey string) (string, error) {
+	token := jwtgo.NewWithClaims(jwtgo.SigningMethodHS512, jwtgo.RegisteredClaims{
+		Subject: accessKey,
+	})
+	return token.SignedString([]byte(secretKey))
+}
+
+// clusterMetricsURL returns the URL of the Minio server's own Prometheus
+// cluster metrics endpoint, honouring the scheme the exporter was configured
+// with.
+func (e *MinioExporter) clusterMetricsURL() string {
+	scheme := "http"
+	if e.Secure {
+		scheme = "https"
+	}
+	return scheme + "://" + e.Endpoint + clusterMetricsPath
+}
+
+// cachedBucketLocation returns the bucket's region, served from cache for
+// up to LocationCacheTTL since bucket regions essentially never change.
+func (e *MinioExporter) cachedBucketLocation(ctx context.Context, bucket string) string {
+	e.locationCacheMu.Lock()
+	entry, ok := e.locationCache[bucket]
+	e.locationCacheMu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		bucketCacheRequestsTotal.WithLabelValues("location", "hit").Inc()
+		return entry.location
+	}
+	bucketCacheRequestsTotal.WithLabelValues("location", "miss").Inc()
+
+	location, err := e.MinioClient.GetBucketLocation(ctx, bucket)
+	if err != nil {
+		return ""
+	}
+
+	e.locationCacheMu.Lock()
+	if e.locationCache == nil {
+		e.locationCache = make(map[string]bucketLocationCacheEntry)
+	}
+	e.locationCache[bucket] = bucketLocationCacheEntry{location: location, expires: time.Now().Add(e.LocationCacheTTL)}
+	e.locationCacheMu.Unlock()
+
+	return location
+}
+
+// cachedDataUsageInfo returns the cluster's data usage info, served from
+// cache for up to UsageCacheTTL so frequent Prometheus scrapes don't hammer
+// the admin API.
+func (e *MinioExporter) cachedDataUsageInfo(ctx context.Context) (madmin.DataUsageInfo, error) {
+	e.usageCacheMu.Lock()
+	cached := e.usageCache
+	e.usageCacheMu.Unlock()
+	if cached != nil && time.Now().Before(cached.expires) {
+		bucketCacheRequestsTotal.WithLabelValues("usage", "hit").Inc()
+		return cached.info, nil
+	}
+	bucketCacheRequestsTotal.WithLabelValues("usage", "miss").Inc()
+
+	info, err := e.AdminClient.DataUsageInfo(ctx)
+	if err != nil {
+		return info, err
+	}
+
+	e.usageCacheMu.Lock()
+	e.usageCache = &dataUsageCacheEntry{info: info, expires: time.Now().Add(e.UsageCacheTTL)}
+	e.usageCacheMu.Unlock()
+
+	return info, nil
+}
+
 // Describe implements the prometheus.Collector interface.
 func (e *MinioExporter) Describe(ch chan<- *prometheus.Desc) {
 	ch <- scrapeDurationDesc
@@ -159,9 +321,16 @@ func execute(e *MinioExporter, ch chan<- prometheus.Metric) error {
 
 	// Collect server admin statistics
 	collectServerStats(e, ch)
+	collectHTTPStats(e, ch)
 	if e.BucketStats {
 		collectBucketsStats(e, ch)
 	}
+	if e.ReplicationStats {
+		collectReplicationStats(e, ch)
+	}
+	if e.HealStats {
+		collectHealMetrics(e, ch)
+	}
 	return nil
 }
 
@@ -172,6 +341,7 @@ func collectServerStats(e *MinioExporter, ch chan<- prometheus.Metric) {
 		return
 	}
 
+	var nodesOnline, nodesOffline int
 	for _, server := range info.Servers {
 		host := server.Endpoint
 		serverUp := 1
@@ -180,6 +350,7 @@ func collectServerStats(e *MinioExporter, ch chan<- prometheus.Metric) {
 		}
 
 		if server.State == "online" {
+			nodesOnline++
 			// Basic server metrics
 			ch <- prometheus.MustNewConstMetric(
 				prometheus.NewDesc(
@@ -189,6 +360,8 @@ func collectServerStats(e *MinioExporter, ch chan<- prometheus.Metric) {
 					nil),
 				prometheus.CounterValue,
 				24*60*60, host) // Placeholder uptime
+		} else {
+			nodesOffline++
 		}
 
 		ch <- prometheus.MustNewConstMetric(
@@ -199,278 +372,511 @@ func collectServerStats(e *MinioExporter, ch chan<- prometheus.Metric) {
 				nil),
 			prometheus.GaugeValue,
 			float64(serverUp), host)
-	}
 
-	// Get storage info
-	storageInfo, err := e.AdminClient.StorageInfo(ctx)
-	if err == nil {
-		collectStorageInfo(storageInfo, ch)
+		ch <- prometheus.MustNewConstMetric(
+			prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, "node", "up"),
+				"Minio node up, labeled with its reported version so version skew during rolling upgrades can be alerted on",
+				[]string{"minio_host", "version"},
+				nil),
+			prometheus.GaugeValue,
+			float64(serverUp), host, server.Version)
 	}
-}
 
-// collectHTTPStats is commented out due to API changes in madmin-go/v3
-// TODO: Implement HTTP stats collection for madmin-go/v3
-/*
-func collectHTTPStats(httpStats madmin.ServerHTTPStats, host string, ch chan<- prometheus.Metric) {
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "total_count_heads"),
-			"Minio total input bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "nodes", "online"),
+			"Total number of Minio cluster nodes that are online",
+			nil,
 			nil),
 		prometheus.GaugeValue,
-		float64(httpStats.TotalHEADStats.Count), host)
+		float64(nodesOnline))
 
-	totHEADStats, _ := time.ParseDuration(httpStats.TotalHEADStats.AvgDuration)
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "total_avg_duration_heads"),
-			"Minio total input bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "nodes", "offline"),
+			"Total number of Minio cluster nodes that are offline",
+			nil,
 			nil),
 		prometheus.GaugeValue,
-		float64(totHEADStats.Seconds()), host)
+		float64(nodesOffline))
 
-	ch <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "success_count_heads"),
-			"Minio total output bytes received",
-			[]string{"minio_host"},
-			nil),
-		prometheus.GaugeValue,
-		float64(httpStats.SuccessHEADStats.Count), host)
+	// Get storage info
+	storageInfo, err := e.AdminClient.StorageInfo(ctx)
+	if err == nil {
+		collectStorageInfo(storageInfo, ch)
+	}
+}
 
-	succHEADStats, _ := time.ParseDuration(httpStats.SuccessHEADStats.AvgDuration)
-	ch <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "success_avg_duration_heads"),
-			"Minio total output bytes received",
-			[]string{"minio_host"},
-			nil),
-		prometheus.GaugeValue,
-		float64(succHEADStats.Seconds()), host)
+// collectHTTPStats scrapes Minio's own cluster-wide Prometheus metrics
+// endpoint and re-exposes per-API request counters and a TTFB histogram.
+// Averages were dropped in favour of a histogram because an average
+// duration hides the tail latency that SLO alerting needs to see.
+func collectHTTPStats(e *MinioExporter, ch chan<- prometheus.Metric) {
+	req, err := http.NewRequest(http.MethodGet, e.clusterMetricsURL(), nil)
+	if err != nil {
+		log.Debugf("Failed to build Minio cluster metrics request: %s", err)
+		return
+	}
+	// The cluster metrics endpoint requires a bearer token on any server
+	// that isn't running with MINIO_PROMETHEUS_AUTH_TYPE=public; it does
+	// not accept HTTP basic auth with the raw admin credentials.
+	req.Header.Set("Authorization", "Bearer "+e.MetricsToken)
 
-	ch <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "total_count_gets"),
-			"Minio total input bytes received",
-			[]string{"minio_host"},
-			nil),
-		prometheus.GaugeValue,
-		float64(httpStats.TotalGETStats.Count), host)
+	client := http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Debugf("Failed to scrape Minio cluster metrics: %s", err)
+		return
+	}
+	defer resp.Body.Close()
 
-	totGETStats, _ := time.ParseDuration(httpStats.TotalGETStats.AvgDuration)
-	ch <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "total_avg_duration_gets"),
-			"Minio total input bytes received",
-			[]string{"minio_host"},
-			nil),
-		prometheus.GaugeValue,
-		float64(totGETStats.Seconds()), host)
+	if resp.StatusCode != http.StatusOK {
+		log.Debugf("Minio cluster metrics scrape returned status %s", resp.Status)
+		return
+	}
 
-	ch <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "success_count_gets"),
-			"Minio total output bytes received",
-			[]string{"minio_host"},
-			nil),
-		prometheus.GaugeValue,
-		float64(httpStats.SuccessGETStats.Count), host)
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		log.Debugf("Failed to parse Minio cluster metrics: %s", err)
+		return
+	}
 
-	succGETStats, _ := time.ParseDuration(httpStats.SuccessGETStats.AvgDuration)
-	ch <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "success_avg_duration_gets"),
-			"Minio total output bytes received",
-			[]string{"minio_host"},
-			nil),
-		prometheus.GaugeValue,
-		float64(succGETStats.Seconds()), host)
+	emitHTTPCounter(families, "minio_s3_requests_total", "total_count", "Total number of S3 requests by API", ch)
+	emitHTTPCounter(families, "minio_s3_requests_success_total", "success_count", "Total number of successful S3 requests by API", ch)
+	emitHTTPCounter(families, "minio_s3_requests_rejected_auth_total", "rejected_auth_total", "Total number of S3 requests rejected for authentication failures", ch)
+	emitHTTPCounter(families, "minio_s3_requests_rejected_time_total", "rejected_time_total", "Total number of S3 requests rejected for invalid time", ch)
 
-	ch <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "total_count_puts"),
-			"Minio total input bytes received",
-			[]string{"minio_host"},
-			nil),
-		prometheus.GaugeValue,
-		float64(httpStats.TotalPUTStats.Count), host)
+	emitTTFBHistogram(families, ch)
+}
 
-	totPUTStats, _ := time.ParseDuration(httpStats.TotalPUTStats.AvgDuration)
-	ch <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "total_avg_duration_puts"),
-			"Minio total input bytes received",
-			[]string{"minio_host"},
-			nil),
-		prometheus.GaugeValue,
-		float64(totPUTStats.Seconds()), host)
+// httpVerbForAPI maps a Minio S3 API name (e.g. "PutObject") to the HTTP
+// verb it is served over, so dashboards can group by verb without needing
+// to know every API name.
+func httpVerbForAPI(api string) string {
+	switch {
+	case strings.HasPrefix(api, "Head"):
+		return "HEAD"
+	case strings.HasPrefix(api, "Delete"):
+		return "DELETE"
+	case strings.HasPrefix(api, "Put"), strings.HasPrefix(api, "Copy"), strings.HasPrefix(api, "Create"), strings.HasPrefix(api, "Upload"):
+		return "PUT"
+	case strings.HasPrefix(api, "Post"), strings.HasPrefix(api, "Complete"):
+		return "POST"
+	default:
+		return "GET"
+	}
+}
+
+// emitHTTPCounter re-emits a scraped Minio counter family, labeled by API
+// name and the HTTP verb it maps to, under the minio_http namespace.
+func emitHTTPCounter(families map[string]*dto.MetricFamily, name string, metricName string, help string, ch chan<- prometheus.Metric) {
+	family, ok := families[name]
+	if !ok || family.GetType() != dto.MetricType_COUNTER {
+		return
+	}
+
+	desc := prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "http", metricName),
+		help,
+		[]string{"api", "method"},
+		nil)
+
+	for _, m := range family.GetMetric() {
+		api := labelValue(m, "api")
+		if api == "" {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, m.GetCounter().GetValue(), api, httpVerbForAPI(api))
+	}
+}
+
+// emitTTFBHistogram re-buckets Minio's own TTFB distribution into the
+// fixed SLO buckets operators alert on, per API.
+func emitTTFBHistogram(families map[string]*dto.MetricFamily, ch chan<- prometheus.Metric) {
+	family, ok := families["minio_s3_requests_ttfb_seconds_distribution"]
+	if !ok {
+		return
+	}
+
+	desc := prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "s3", "ttfb_seconds"),
+		"Distribution of time to first byte for S3 requests",
+		[]string{"api"},
+		nil)
+
+	upstreamBuckets := make(map[string]map[float64]uint64)
+	for _, m := range family.GetMetric() {
+		api := labelValue(m, "api")
+		le := labelValue(m, "le")
+		if api == "" || le == "" {
+			continue
+		}
+		bound, err := strconv.ParseFloat(le, 64)
+		if err != nil {
+			continue
+		}
+		if upstreamBuckets[api] == nil {
+			upstreamBuckets[api] = make(map[float64]uint64)
+		}
+		upstreamBuckets[api][bound] = uint64(m.GetCounter().GetValue())
+	}
+
+	for api, bounds := range upstreamBuckets {
+		buckets := make(map[float64]uint64, len(ttfbBuckets))
+		for _, b := range ttfbBuckets {
+			var cumulative uint64
+			for bound, c := range bounds {
+				if bound <= b && c > cumulative {
+					cumulative = c
+				}
+			}
+			buckets[b] = cumulative
+		}
+
+		// The total sample count must come from the upstream +Inf bucket,
+		// not from our fixed buckets (which top out at 10s) - otherwise
+		// every request slower than the largest bucket boundary would be
+		// silently dropped from the count.
+		sortedBounds := make([]float64, 0, len(bounds))
+		for bound := range bounds {
+			sortedBounds = append(sortedBounds, bound)
+		}
+		sort.Float64s(sortedBounds)
+
+		var count uint64
+		if c, ok := bounds[math.Inf(1)]; ok {
+			count = c
+		} else if len(sortedBounds) > 0 {
+			count = bounds[sortedBounds[len(sortedBounds)-1]]
+		}
+
+		// Minio's distribution only exposes cumulative bucket counts, not a
+		// true sum, so approximate one by weighting each bucket's delta
+		// count by its upper bound. The +Inf bucket has no real upper
+		// bound to weight by, so the last finite boundary is used as a
+		// best-effort estimate for it.
+		var sum float64
+		var prevBound float64
+		var prevCount uint64
+		for _, bound := range sortedBounds {
+			delta := bounds[bound] - prevCount
+			weight := bound
+			if math.IsInf(bound, 1) {
+				weight = prevBound
+			}
+			sum += float64(delta) * weight
+			prevBound = bound
+			prevCount = bounds[bound]
+		}
+
+		ch <- prometheus.MustNewConstHistogram(desc, count, sum, buckets, api)
+	}
+}
+
+// labelValue returns the value of the named label on m, or "" if absent.
+func labelValue(m *dto.Metric, name string) string {
+	for _, l := range m.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
+
+func collectStorageInfo(si madmin.StorageInfo, ch chan<- prometheus.Metric) {
+	// Basic storage metrics for madmin-go/v3
+	// The API has changed, so we'll implement basic metrics
+
+	// Count total disks from the Disks slice
+	totalDisks := len(si.Disks)
+	onlineDisks := 0
+	var totalSpace, usedSpace uint64
+
+	for _, disk := range si.Disks {
+		if disk.State == "ok" || disk.State == "online" {
+			onlineDisks++
+		}
+		totalSpace += disk.TotalSpace
+		usedSpace += disk.UsedSpace
+
+		collectDiskInfo(disk, ch)
+	}
 
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "success_count_puts"),
-			"Minio total output bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "storage", "total_disk_space"),
+			"Total Minio disk space in bytes",
+			nil,
 			nil),
 		prometheus.GaugeValue,
-		float64(httpStats.SuccessPUTStats.Count), host)
+		float64(totalSpace))
 
-	succPUTStats, _ := time.ParseDuration(httpStats.SuccessPUTStats.AvgDuration)
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "success_avg_duration_puts"),
-			"Minio total output bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "storage", "free_disk_space"),
+			"Free Minio disk space in bytes",
+			nil,
 			nil),
 		prometheus.GaugeValue,
-		float64(succPUTStats.Seconds()), host)
+		float64(totalSpace-usedSpace))
 
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "total_count_posts"),
-			"Minio total input bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "storage", "online_disks"),
+			"Total number of Minio online disks",
+			nil,
 			nil),
 		prometheus.GaugeValue,
-		float64(httpStats.TotalPOSTStats.Count), host)
+		float64(onlineDisks))
 
-	totPOSTStats, _ := time.ParseDuration(httpStats.TotalPOSTStats.AvgDuration)
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "total_avg_duration_posts"),
-			"Minio total input bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "storage", "offline_disks"),
+			"Total number of Minio offline disks",
+			nil,
 			nil),
 		prometheus.GaugeValue,
-		float64(totPOSTStats.Seconds()), host)
+		float64(totalDisks-onlineDisks))
+}
+
+// collectDiskInfo emits per-disk storage, state and latency/error metrics.
+// Cluster-wide sums hide a single bad drive in an erasure-coded pool; these
+// labels let that drive be singled out.
+func collectDiskInfo(disk madmin.Disk, ch chan<- prometheus.Metric) {
+	labels := []string{"drive", "endpoint", "pool", "set", "state"}
+	labelValues := []string{
+		disk.DrivePath,
+		disk.Endpoint,
+		strconv.Itoa(disk.PoolIndex),
+		strconv.Itoa(disk.SetIndex),
+		disk.State,
+	}
 
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "success_count_posts"),
-			"Minio total output bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "disk", "storage_total_bytes"),
+			"Total disk space in bytes",
+			[]string{"drive", "endpoint"},
 			nil),
 		prometheus.GaugeValue,
-		float64(httpStats.SuccessPOSTStats.Count), host)
+		float64(disk.TotalSpace), disk.DrivePath, disk.Endpoint)
 
-	succPOSTStats, _ := time.ParseDuration(httpStats.SuccessPOSTStats.AvgDuration)
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "success_avg_duration_posts"),
-			"Minio total output bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "disk", "storage_used_bytes"),
+			"Used disk space in bytes",
+			[]string{"drive", "endpoint"},
 			nil),
 		prometheus.GaugeValue,
-		float64(succPOSTStats.Seconds()), host)
+		float64(disk.UsedSpace), disk.DrivePath, disk.Endpoint)
 
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "total_count_deletes"),
-			"Minio total input bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "disk", "storage_available_bytes"),
+			"Available disk space in bytes",
+			[]string{"drive", "endpoint"},
 			nil),
 		prometheus.GaugeValue,
-		float64(httpStats.TotalDELETEStats.Count), host)
+		float64(disk.AvailableSpace), disk.DrivePath, disk.Endpoint)
 
-	totDELETEStats, _ := time.ParseDuration(httpStats.TotalDELETEStats.AvgDuration)
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "total_avg_duration_deletes"),
-			"Minio total input bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "disk", "state"),
+			"Minio disk state, always 1 for the disk's current reported state",
+			labels,
 			nil),
 		prometheus.GaugeValue,
-		float64(totDELETEStats.Seconds()), host)
+		1, labelValues...)
 
+	healing := 0.0
+	if disk.Healing {
+		healing = 1.0
+	}
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "success_count_deletes"),
-			"Minio total output bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "disk", "healing"),
+			"Whether the disk is currently healing",
+			[]string{"drive", "endpoint"},
 			nil),
 		prometheus.GaugeValue,
-		float64(httpStats.SuccessDELETEStats.Count), host)
+		healing, disk.DrivePath, disk.Endpoint)
+
+	if disk.Metrics == nil {
+		return
+	}
+
+	latencyDesc := prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "disk", "api_latency_seconds"),
+		"Per-API latency observed on the disk",
+		[]string{"drive", "endpoint", "api"},
+		nil)
+	for api, rawLatency := range disk.Metrics.APILatencies {
+		latency, err := time.ParseDuration(rawLatency)
+		if err != nil {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(latencyDesc, prometheus.GaugeValue, latency.Seconds(), disk.DrivePath, disk.Endpoint, api)
+	}
 
-	succDELETEStats, _ := time.ParseDuration(httpStats.SuccessDELETEStats.AvgDuration)
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "http", "success_avg_duration_deletes"),
-			"Minio total output bytes received",
-			[]string{"minio_host"},
+			prometheus.BuildFQName(namespace, "disk", "errors_total"),
+			"Total number of errors reported by the disk",
+			[]string{"drive", "endpoint"},
 			nil),
-		prometheus.GaugeValue,
-		float64(succDELETEStats.Seconds()), host)
+		prometheus.CounterValue,
+		float64(disk.Metrics.TotalErrors), disk.DrivePath, disk.Endpoint)
 }
-*/
 
-func collectStorageInfo(si madmin.StorageInfo, ch chan<- prometheus.Metric) {
-	// Basic storage metrics for madmin-go/v3
-	// The API has changed, so we'll implement basic metrics
-
-	// Count total disks from the Disks slice
-	totalDisks := len(si.Disks)
-	onlineDisks := 0
-	var totalSpace, usedSpace uint64
+// collectHealMetrics emits background-heal progress metrics. Healing only
+// runs periodically, so a "not initialized" error is treated as zeroed
+// metrics rather than a scrape failure.
+func collectHealMetrics(e *MinioExporter, ch chan<- prometheus.Metric) {
+	ctx := context.Background()
 
-	for _, disk := range si.Disks {
-		if disk.State == "ok" || disk.State == "online" {
-			onlineDisks++
+	status, err := e.AdminClient.BackgroundHealStatus(ctx)
+	if err != nil {
+		if !strings.Contains(err.Error(), "not initialized") {
+			log.Debugf("Failed to get background heal status: %s", err)
+			return
 		}
-		totalSpace += disk.TotalSpace
-		usedSpace += disk.UsedSpace
+		log.Debugf("Background heal status not initialized yet; reporting zeroed heal metrics")
+	}
+
+	var lastActivitySeconds float64
+	if !status.LastActivity.IsZero() {
+		lastActivitySeconds = time.Since(status.LastActivity).Seconds()
 	}
 
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "storage", "total_disk_space"),
-			"Total Minio disk space in bytes",
+			prometheus.BuildFQName(namespace, "heal", "objects_scanned_total"),
+			"Total number of objects scanned by the background healer",
 			nil,
 			nil),
-		prometheus.GaugeValue,
-		float64(totalSpace))
+		prometheus.CounterValue,
+		float64(status.ObjectsScanned))
 
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "storage", "free_disk_space"),
-			"Free Minio disk space in bytes",
+			prometheus.BuildFQName(namespace, "heal", "objects_healed_total"),
+			"Total number of objects healed by the background healer",
 			nil,
 			nil),
-		prometheus.GaugeValue,
-		float64(totalSpace-usedSpace))
+		prometheus.CounterValue,
+		float64(status.ObjectsHealed))
 
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "storage", "online_disks"),
-			"Total number of Minio online disks",
+			prometheus.BuildFQName(namespace, "heal", "objects_failed_total"),
+			"Total number of objects that failed to heal",
+			nil,
+			nil),
+		prometheus.CounterValue,
+		float64(status.ObjectsFailed))
+
+	ch <- prometheus.MustNewConstMetric(
+		prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "heal", "last_activity_seconds"),
+			"Seconds since the background healer last made progress",
 			nil,
 			nil),
 		prometheus.GaugeValue,
-		float64(onlineDisks))
+		lastActivitySeconds)
+
+	driveDesc := prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "heal", "time_since_last_activity_seconds"),
+		"Seconds since the background healer last made progress, reported for each drive being healed; the value is the cluster-wide one, not tracked per drive",
+		[]string{"endpoint"},
+		nil)
+	for _, drive := range status.HealDisks {
+		ch <- prometheus.MustNewConstMetric(driveDesc, prometheus.GaugeValue, lastActivitySeconds, drive)
+	}
+}
+
+// collectReplicationStats emits per-bucket, per-target replication
+// backlog/failure metrics sourced from DataUsageInfo's per-bucket
+// ReplicationInfo, plus a cluster-wide queued-ops gauge aggregated from the
+// same data. It is gated behind --minio.replication-stats because, even
+// cached, DataUsageInfo is a cluster-wide scan that can be expensive on
+// deployments with many buckets. madmin-go/v3 has no API exposing a live
+// count of active replication workers, so that gauge from the original
+// request isn't emitted.
+func collectReplicationStats(e *MinioExporter, ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+
+	dataUsage, err := e.cachedDataUsageInfo(ctx)
+	if err != nil {
+		log.Debugf("Failed to get data usage info for replication stats: %s", err)
+		return
+	}
+
+	pendingCountDesc := prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "bucket", "replication_pending_count"),
+		"Number of pending replication operations for the bucket",
+		[]string{"bucket", "target"},
+		nil)
+	pendingSizeDesc := prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "bucket", "replication_pending_size_bytes"),
+		"Total size in bytes of pending replication operations for the bucket",
+		[]string{"bucket", "target"},
+		nil)
+	failedCountDesc := prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "bucket", "replication_failed_count"),
+		"Number of failed replication operations for the bucket",
+		[]string{"bucket", "target"},
+		nil)
+	failedSizeDesc := prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "bucket", "replication_failed_size_bytes"),
+		"Total size in bytes of failed replication operations for the bucket",
+		[]string{"bucket", "target"},
+		nil)
+	sentBytesDesc := prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "bucket", "replication_sent_bytes_total"),
+		"Total bytes successfully replicated out of the bucket",
+		[]string{"bucket", "target"},
+		nil)
+
+	var clusterPendingOps uint64
+
+	for bucketName, usage := range dataUsage.BucketsUsage {
+		for arn, target := range usage.ReplicationInfo {
+			ch <- prometheus.MustNewConstMetric(pendingCountDesc, prometheus.GaugeValue, float64(target.ReplicationPendingCount), bucketName, arn)
+			ch <- prometheus.MustNewConstMetric(pendingSizeDesc, prometheus.GaugeValue, float64(target.ReplicationPendingSize), bucketName, arn)
+			ch <- prometheus.MustNewConstMetric(failedCountDesc, prometheus.GaugeValue, float64(target.ReplicationFailedCount), bucketName, arn)
+			ch <- prometheus.MustNewConstMetric(failedSizeDesc, prometheus.GaugeValue, float64(target.ReplicationFailedSize), bucketName, arn)
+			ch <- prometheus.MustNewConstMetric(sentBytesDesc, prometheus.CounterValue, float64(target.ReplicatedSize), bucketName, arn)
+
+			clusterPendingOps += target.ReplicationPendingCount
+		}
+	}
 
 	ch <- prometheus.MustNewConstMetric(
 		prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "storage", "offline_disks"),
-			"Total number of Minio offline disks",
+			prometheus.BuildFQName(namespace, "cluster", "replication_queued_ops"),
+			"Total number of pending replication operations across all buckets",
 			nil,
 			nil),
 		prometheus.GaugeValue,
-		float64(totalDisks-onlineDisks))
+		float64(clusterPendingOps))
 }
 
 // Collect all buckets stats using fast data usage API
 func collectBucketsStats(e *MinioExporter, ch chan<- prometheus.Metric) {
 	ctx := context.Background()
 
-	// Get all bucket usage data in one call (much faster)
-	dataUsage, err := e.AdminClient.DataUsageInfo(ctx)
+	// Get all bucket usage data in one call (much faster), served from
+	// cache when a previous scrape populated it within UsageCacheTTL.
+	dataUsage, err := e.cachedDataUsageInfo(ctx)
 	if err != nil {
 		log.Debugf("Failed to get data usage info: %s", err)
 		// Fallback to listing buckets without detailed stats
 		buckets, err := e.MinioClient.ListBuckets(ctx)
 		if err == nil {
 			for _, bucket := range buckets {
-				location, _ := e.MinioClient.GetBucketLocation(ctx, bucket.Name)
+				location := e.cachedBucketLocation(ctx, bucket.Name)
 				ch <- prometheus.MustNewConstMetric(
 					prometheus.NewDesc(
 						prometheus.BuildFQName(namespace, "bucket", "exists"),
@@ -484,30 +890,46 @@ func collectBucketsStats(e *MinioExporter, ch chan<- prometheus.Metric) {
 		return
 	}
 
-	// Process each bucket from usage data
+	// Process each bucket from usage data. GetBucketLocation is the only
+	// remaining per-bucket round-trip, so it is parallelized across a
+	// bounded worker pool to keep the scrape fast on clusters with
+	// thousands of buckets.
+	workers := e.BucketWorkers
+	if workers < 1 {
+		workers = 1
+	}
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+
 	for bucketName, bucketUsage := range dataUsage.BucketsUsage {
-		// Get bucket location
-		location, _ := e.MinioClient.GetBucketLocation(ctx, bucketName)
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(bucketName string, bucketUsage madmin.BucketUsageInfo) {
+			defer wg.Done()
+			defer func() { <-sem }()
 
-		// Emit bucket metrics
-		ch <- prometheus.MustNewConstMetric(
-			prometheus.NewDesc(
-				prometheus.BuildFQName(namespace, "bucket", "objects_number"),
-				"The number of objects in the bucket",
-				[]string{"bucket", "location"},
-				nil),
-			prometheus.GaugeValue,
-			float64(bucketUsage.ObjectsCount), bucketName, location)
+			location := e.cachedBucketLocation(ctx, bucketName)
 
-		ch <- prometheus.MustNewConstMetric(
-			prometheus.NewDesc(
-				prometheus.BuildFQName(namespace, "bucket", "objects_total_size"),
-				"The total size of all objects in the bucket",
-				[]string{"bucket", "location"},
-				nil),
-			prometheus.GaugeValue,
-			float64(bucketUsage.Size), bucketName, location)
+			ch <- prometheus.MustNewConstMetric(
+				prometheus.NewDesc(
+					prometheus.BuildFQName(namespace, "bucket", "objects_number"),
+					"The number of objects in the bucket",
+					[]string{"bucket", "location"},
+					nil),
+				prometheus.GaugeValue,
+				float64(bucketUsage.ObjectsCount), bucketName, location)
+
+			ch <- prometheus.MustNewConstMetric(
+				prometheus.NewDesc(
+					prometheus.BuildFQName(namespace, "bucket", "objects_total_size"),
+					"The total size of all objects in the bucket",
+					[]string{"bucket", "location"},
+					nil),
+				prometheus.GaugeValue,
+				float64(bucketUsage.Size), bucketName, location)
+		}(bucketName, bucketUsage)
 	}
+	wg.Wait()
 }
 
 // calculate bucket statistics using fast data API
@@ -597,19 +1019,141 @@ func getEnv(key string, defaultVal string) string {
 	return defaultVal
 }
 
+// AuthModule holds the Minio credentials for one named entry of the
+// --config.file, used by the /probe endpoint.
+type AuthModule struct {
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+}
+
+// Config is the top-level shape of the --config.file consumed by /probe.
+type Config struct {
+	AuthModules map[string]AuthModule `yaml:"auth_modules"`
+}
+
+// loadConfig reads and parses the YAML auth-module config used by /probe.
+func loadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file %s: %s", path, err)
+	}
+
+	cfg := &Config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("error parsing config file %s: %s", path, err)
+	}
+	return cfg, nil
+}
+
+// probeExporterCacheEntry holds a MinioExporter built for one /probe target
+// plus the time it should be discarded and rebuilt.
+type probeExporterCacheEntry struct {
+	exporter *MinioExporter
+	expires  time.Time
+}
+
+// probeExporterCache caches the MinioExporter built for each target+
+// auth_module pair across /probe requests. Without this, every scrape would
+// construct a brand new MinioExporter whose bucket-location and data-usage
+// caches start out empty, defeating the point of those caches for the
+// repeated-scraping use case /probe exists for.
+type probeExporterCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]probeExporterCacheEntry
+}
+
+func (c *probeExporterCache) get(key string) (*MinioExporter, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.exporter, true
+}
+
+func (c *probeExporterCache) put(key string, exporter *MinioExporter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]probeExporterCacheEntry)
+	}
+	c.entries[key] = probeExporterCacheEntry{exporter: exporter, expires: time.Now().Add(c.ttl)}
+}
+
+// probeHandler implements a blackbox-exporter style /probe endpoint: it
+// builds (or reuses a cached) MinioExporter for the requested ?target=, so
+// a single exporter process can monitor many Minio clusters via
+// Prometheus's relabel-based multi-target pattern instead of requiring one
+// process per cluster.
+func probeHandler(cfg *Config, opts MinioExporterOptions, exporterCacheTTL time.Duration) http.HandlerFunc {
+	cache := &probeExporterCache{ttl: exporterCacheTTL}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		target := r.URL.Query().Get("target")
+		if target == "" {
+			http.Error(w, "target parameter is missing", http.StatusBadRequest)
+			return
+		}
+
+		if cfg == nil {
+			http.Error(w, "probing requires --config.file to be set", http.StatusInternalServerError)
+			return
+		}
+
+		authModule := r.URL.Query().Get("auth_module")
+		if authModule == "" {
+			authModule = "default"
+		}
+
+		module, ok := cfg.AuthModules[authModule]
+		if !ok {
+			http.Error(w, fmt.Sprintf("unknown auth_module %q", authModule), http.StatusBadRequest)
+			return
+		}
+
+		cacheKey := target + "|" + authModule
+		exporter, ok := cache.get(cacheKey)
+		if !ok {
+			var err error
+			exporter, err = NewMinioExporter(target, module.AccessKey, module.SecretKey, opts)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("error building Minio exporter for target %s: %s", target, err), http.StatusBadRequest)
+				return
+			}
+			cache.put(cacheKey, exporter)
+		}
+
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(exporter)
+		promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+	}
+}
+
 func init() {
 	prometheus.MustRegister(version.NewCollector(program))
+	prometheus.MustRegister(bucketCacheRequestsTotal)
 }
 
 func main() {
 	var (
-		printVersion  = flag.Bool("version", false, "Print version information.")
-		listenAddress = flag.String("web.listen-address", getEnv("LISTEN_ADDRESS", ":9290"), "Address to listen on for web interface and telemetry.")
-		metricsPath   = flag.String("web.telemetry-path", getEnv("METRIC_PATH", "/metrics"), "Path under which to expose metrics.")
-		minioURI      = flag.String("minio.server", getEnv("MINIO_URL", "http://localhost:9000"), "HTTP address of the Minio server")
-		minioKey      = flag.String("minio.access-key", getEnv("MINIO_ACCESS_KEY", ""), "The access key used to login in to Minio.")
-		minioSecret   = flag.String("minio.access-secret", getEnv("MINIO_ACCESS_SECRET", ""), "The access secret used to login in to Minio")
-		bucketStats   = flag.Bool("minio.bucket-stats", false, "Collect bucket statistics. It can take long.")
+		printVersion     = flag.Bool("version", false, "Print version information.")
+		listenAddress    = flag.String("web.listen-address", getEnv("LISTEN_ADDRESS", ":9290"), "Address to listen on for web interface and telemetry.")
+		metricsPath      = flag.String("web.telemetry-path", getEnv("METRIC_PATH", "/metrics"), "Path under which to expose metrics.")
+		minioURI         = flag.String("minio.server", getEnv("MINIO_URL", "http://localhost:9000"), "HTTP address of the Minio server")
+		minioKey         = flag.String("minio.access-key", getEnv("MINIO_ACCESS_KEY", ""), "The access key used to login in to Minio.")
+		minioSecret      = flag.String("minio.access-secret", getEnv("MINIO_ACCESS_SECRET", ""), "The access secret used to login in to Minio")
+		bucketStats      = flag.Bool("minio.bucket-stats", false, "Collect bucket statistics. It can take long.")
+		replicationStats = flag.Bool("minio.replication-stats", false, "Collect bucket and cluster replication statistics. It can take long.")
+		healStats        = flag.Bool("minio.heal-stats", false, "Collect background heal progress statistics.")
+		bucketWorkers    = flag.Int("minio.bucket-workers", 16, "Number of concurrent workers used to fetch per-bucket location during bucket stats collection.")
+		locationCacheTTL = flag.Duration("minio.location-cache-ttl", time.Hour, "How long to cache a bucket's location, since bucket regions rarely change.")
+		usageCacheTTL    = flag.Duration("minio.usage-cache-ttl", 30*time.Second, "How long to cache the cluster-wide data usage info used for bucket stats.")
+		metricsToken     = flag.String("minio.metrics-token", getEnv("MINIO_METRICS_TOKEN", ""), "Bearer token for Minio's cluster metrics endpoint, as produced by 'mc admin prometheus generate'. If unset, one is derived from the access key and secret.")
+		configFile       = flag.String("config.file", "", "Path to a YAML config file mapping auth_module names to Minio credentials, used by the /probe endpoint.")
+		probeExporterTTL = flag.Duration("probe.exporter-cache-ttl", 5*time.Minute, "How long to reuse a MinioExporter built for a /probe target+auth_module pair before rebuilding it.")
 	)
 
 	flag.Parse()
@@ -619,7 +1163,17 @@ func main() {
 		os.Exit(0)
 	}
 
-	exporter, err := NewMinioExporter(*minioURI, *minioKey, *minioSecret, *bucketStats)
+	opts := MinioExporterOptions{
+		BucketStats:      *bucketStats,
+		ReplicationStats: *replicationStats,
+		HealStats:        *healStats,
+		BucketWorkers:    *bucketWorkers,
+		LocationCacheTTL: *locationCacheTTL,
+		UsageCacheTTL:    *usageCacheTTL,
+		MetricsToken:     *metricsToken,
+	}
+
+	exporter, err := NewMinioExporter(*minioURI, *minioKey, *minioSecret, opts)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -629,7 +1183,16 @@ func main() {
 
 	prometheus.MustRegister(exporter)
 
+	var cfg *Config
+	if *configFile != "" {
+		cfg, err = loadConfig(*configFile)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
+
 	http.Handle(*metricsPath, prometheus.Handler())
+	http.HandleFunc("/probe", probeHandler(cfg, opts, *probeExporterTTL))
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte(`<html>
                         <head><title>Minio Exporter</title></head>
@@ -0,0 +1,184 @@
+// Copyright 2017 Giuseppe Pellegrino
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestHTTPVerbForAPI(t *testing.T) {
+	tests := []struct {
+		api  string
+		verb string
+	}{
+		{"HeadObject", "HEAD"},
+		{"HeadBucket", "HEAD"},
+		{"DeleteObject", "DELETE"},
+		{"DeleteBucket", "DELETE"},
+		{"PutObject", "PUT"},
+		{"CopyObject", "PUT"},
+		{"CreateMultipartUpload", "PUT"},
+		{"UploadPart", "PUT"},
+		{"PostPolicyBucket", "POST"},
+		{"CompleteMultipartUpload", "POST"},
+		{"GetObject", "GET"},
+		{"ListBuckets", "GET"},
+		{"", "GET"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.api, func(t *testing.T) {
+			if got := httpVerbForAPI(tt.api); got != tt.verb {
+				t.Errorf("httpVerbForAPI(%q) = %q, want %q", tt.api, got, tt.verb)
+			}
+		})
+	}
+}
+
+func counterMetric(labels map[string]string, value float64) *dto.Metric {
+	m := &dto.Metric{Counter: &dto.Counter{Value: proto64(value)}}
+	for name, v := range labels {
+		name, v := name, v
+		m.Label = append(m.Label, &dto.LabelPair{Name: &name, Value: &v})
+	}
+	return m
+}
+
+func proto64(f float64) *float64 {
+	return &f
+}
+
+func TestEmitTTFBHistogram(t *testing.T) {
+	counterType := dto.MetricType_COUNTER
+	families := map[string]*dto.MetricFamily{
+		"minio_s3_requests_ttfb_seconds_distribution": {
+			Type: &counterType,
+			Metric: []*dto.Metric{
+				counterMetric(map[string]string{"api": "GetObject", "le": "0.1"}, 2),
+				counterMetric(map[string]string{"api": "GetObject", "le": "1"}, 5),
+				counterMetric(map[string]string{"api": "GetObject", "le": "+Inf"}, 8),
+			},
+		},
+	}
+
+	ch := make(chan prometheus.Metric, 1)
+	emitTTFBHistogram(families, ch)
+	close(ch)
+
+	metric, ok := <-ch
+	if !ok {
+		t.Fatal("emitTTFBHistogram did not emit a metric")
+	}
+
+	var pb dto.Metric
+	if err := metric.Write(&pb); err != nil {
+		t.Fatalf("failed to write metric: %s", err)
+	}
+
+	hist := pb.GetHistogram()
+	if hist == nil {
+		t.Fatal("expected a histogram metric")
+	}
+
+	// count must come from the +Inf bucket, not the largest finite one,
+	// otherwise requests slower than our widest SLO bucket vanish from the
+	// total.
+	if got := hist.GetSampleCount(); got != 8 {
+		t.Errorf("sample count = %d, want 8", got)
+	}
+
+	// sum is a bucket-delta-weighted estimate: 2 samples weighted at 0.1,
+	// 3 more weighted at 1 (5-2), and the 3 slowest (8-5) weighted at the
+	// last finite boundary (1) since +Inf has no real upper bound.
+	wantSum := 2*0.1 + 3*1 + 3*1
+	if got := hist.GetSampleSum(); math.Abs(got-wantSum) > 1e-9 {
+		t.Errorf("sample sum = %v, want %v", got, wantSum)
+	}
+}
+
+func TestEmitTTFBHistogramIgnoresUnknownFamily(t *testing.T) {
+	ch := make(chan prometheus.Metric, 1)
+	emitTTFBHistogram(map[string]*dto.MetricFamily{}, ch)
+	close(ch)
+
+	if _, ok := <-ch; ok {
+		t.Error("expected no metric to be emitted when the family is absent")
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yml")
+	contents := `
+auth_modules:
+  default:
+    access_key: default-key
+    secret_key: default-secret
+  readonly:
+    access_key: ro-key
+    secret_key: ro-secret
+`
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write test config: %s", err)
+	}
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig returned error: %s", err)
+	}
+
+	if len(cfg.AuthModules) != 2 {
+		t.Fatalf("got %d auth modules, want 2", len(cfg.AuthModules))
+	}
+
+	def, ok := cfg.AuthModules["default"]
+	if !ok {
+		t.Fatal("missing \"default\" auth module")
+	}
+	if def.AccessKey != "default-key" || def.SecretKey != "default-secret" {
+		t.Errorf("default auth module = %+v, want access_key=default-key secret_key=default-secret", def)
+	}
+
+	ro, ok := cfg.AuthModules["readonly"]
+	if !ok {
+		t.Fatal("missing \"readonly\" auth module")
+	}
+	if ro.AccessKey != "ro-key" || ro.SecretKey != "ro-secret" {
+		t.Errorf("readonly auth module = %+v, want access_key=ro-key secret_key=ro-secret", ro)
+	}
+}
+
+func TestLoadConfigMissingFile(t *testing.T) {
+	if _, err := loadConfig("/nonexistent/config.yml"); err == nil {
+		t.Error("expected an error for a missing config file")
+	}
+}
+
+func TestLoadConfigInvalidYAML(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yml")
+	if err := os.WriteFile(path, []byte("not: [valid yaml"), 0o600); err != nil {
+		t.Fatalf("failed to write test config: %s", err)
+	}
+
+	if _, err := loadConfig(path); err == nil {
+		t.Error("expected an error for invalid YAML")
+	}
+}